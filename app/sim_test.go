@@ -32,6 +32,12 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
 	ibchost "github.com/cosmos/ibc-go/v2/modules/core/24-host"
+
+	audittypes "github.com/ovrclk/akash/x/audit/types/v1beta2"
+	deploymenttypes "github.com/ovrclk/akash/x/deployment/types/v1beta2"
+	escrowtypes "github.com/ovrclk/akash/x/escrow/types/v1beta2"
+	markettypes "github.com/ovrclk/akash/x/market/types/v1beta2"
+	providertypes "github.com/ovrclk/akash/x/provider/types/v1beta2"
 )
 
 // Get flags every time the simulator is run
@@ -174,6 +180,11 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
 		{app.keys[ibchost.StoreKey], newApp.keys[ibchost.StoreKey], [][]byte{}},
 		{app.keys[ibctransfertypes.StoreKey], newApp.keys[ibctransfertypes.StoreKey], [][]byte{}},
+		{app.keys[deploymenttypes.StoreKey], newApp.keys[deploymenttypes.StoreKey], [][]byte{}},
+		{app.keys[escrowtypes.StoreKey], newApp.keys[escrowtypes.StoreKey], [][]byte{}},
+		{app.keys[markettypes.StoreKey], newApp.keys[markettypes.StoreKey], [][]byte{}},
+		{app.keys[providertypes.StoreKey], newApp.keys[providertypes.StoreKey], [][]byte{}},
+		{app.keys[audittypes.StoreKey], newApp.keys[audittypes.StoreKey], [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
@@ -2,6 +2,7 @@ package audit
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/ovrclk/akash/x/audit/keeper"
@@ -11,17 +12,55 @@ import (
 
 // ValidateGenesis does validation check of the Genesis and returns error incase of failure
 func ValidateGenesis(data *types.GenesisState) error {
+	signed := make(map[string]struct{}, len(data.Attributes))
+
+	for idx, record := range data.Attributes {
+		id, err := providerIDFromGenesis(record)
+		if err != nil {
+			return errors.Wrapf(types.ErrInvalidAddress, "error with attributes %s/%s (idx %v): %s",
+				record.Auditor, record.Owner, idx, err)
+		}
+
+		key := id.Owner.String() + "/" + id.Auditor.String()
+		if _, found := signed[key]; found {
+			return errors.Wrapf(types.ErrProviderExists, "duplicate attributes %s/%s (idx %v)",
+				record.Auditor, record.Owner, idx)
+		}
+		signed[key] = struct{}{}
+	}
+
 	return nil
 }
 
 // InitGenesis initiate genesis state and return updated validator details
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
+	for _, record := range data.Attributes {
+		id, err := providerIDFromGenesis(record)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SaveProvider(ctx, id, types.Provider{
+			Owner:      record.Owner,
+			Auditor:    record.Auditor,
+			Attributes: record.Attributes,
+		})
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns genesis state as raw bytes for the provider module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	return &types.GenesisState{}
+	state := &types.GenesisState{}
+	k.WithProviders(ctx, func(obj types.Provider) bool {
+		state.Attributes = append(state.Attributes, types.AuditedAttributes{
+			Owner:      obj.Owner,
+			Auditor:    obj.Auditor,
+			Attributes: obj.Attributes,
+		})
+		return false
+	})
+	return state
 }
 
 // DefaultGenesisState returns default genesis state as raw bytes for the provider
@@ -29,3 +68,20 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 func DefaultGenesisState() *types.GenesisState {
 	return &types.GenesisState{}
 }
+
+func providerIDFromGenesis(record types.AuditedAttributes) (types.ProviderID, error) {
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return types.ProviderID{}, err
+	}
+
+	auditor, err := sdk.AccAddressFromBech32(record.Auditor)
+	if err != nil {
+		return types.ProviderID{}, err
+	}
+
+	return types.ProviderID{
+		Owner:   owner,
+		Auditor: auditor,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/testutil/state"
+	"github.com/ovrclk/akash/x/audit"
+	"github.com/ovrclk/akash/x/audit/keeper"
+	types "github.com/ovrclk/akash/x/audit/types/v1beta2"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	suite := state.SetupTestSuite(t)
+	ctx := suite.Context()
+	akeeper := keeper.NewKeeper(types.ModuleCdc, suite.App().GetKey(types.StoreKey))
+
+	id := types.ProviderID{
+		Owner:   testutil.AccAddress(t),
+		Auditor: testutil.AccAddress(t),
+	}
+	require.NoError(t, akeeper.CreateOrUpdateProviderAttributes(ctx, id, testutil.Attributes(t)))
+
+	exported := audit.ExportGenesis(ctx, akeeper)
+	require.Len(t, exported.Attributes, 1)
+	require.NoError(t, audit.ValidateGenesis(exported))
+
+	isuite := state.SetupTestSuite(t)
+	ictx := isuite.Context()
+	ikeeper := keeper.NewKeeper(types.ModuleCdc, isuite.App().GetKey(types.StoreKey))
+
+	audit.InitGenesis(ictx, ikeeper, exported)
+	require.Equal(t, exported, audit.ExportGenesis(ictx, ikeeper))
+
+	expected, _ := akeeper.GetProviderByAuditor(ctx, id)
+	result, found := ikeeper.GetProviderByAuditor(ictx, id)
+	require.True(t, found)
+	require.Equal(t, expected, result)
+}
+
+func TestValidateGenesisInvalidAuditor(t *testing.T) {
+	err := audit.ValidateGenesis(&types.GenesisState{
+		Attributes: []types.AuditedAttributes{{
+			Owner:      testutil.AccAddress(t).String(),
+			Auditor:    "akash1invalid",
+			Attributes: testutil.Attributes(t),
+		}},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+}
+
+func TestValidateGenesisDuplicateAttributes(t *testing.T) {
+	record := types.AuditedAttributes{
+		Owner:      testutil.AccAddress(t).String(),
+		Auditor:    testutil.AccAddress(t).String(),
+		Attributes: testutil.Attributes(t),
+	}
+
+	err := audit.ValidateGenesis(&types.GenesisState{
+		Attributes: []types.AuditedAttributes{record, record},
+	})
+	require.ErrorIs(t, err, types.ErrProviderExists)
+}
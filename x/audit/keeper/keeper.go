@@ -19,6 +19,7 @@ type IKeeper interface {
 	DeleteProviderAttributes(ctx sdk.Context, id types.ProviderID, keys []string) error
 	WithProviders(ctx sdk.Context, fn func(types.Provider) bool)
 	WithProvider(ctx sdk.Context, id sdk.Address, fn func(types.Provider) bool)
+	SaveProvider(ctx sdk.Context, id types.ProviderID, prov types.Provider)
 }
 
 // Keeper of the provider store
@@ -129,6 +130,13 @@ func (k Keeper) CreateOrUpdateProviderAttributes(ctx sdk.Context, id types.Provi
 	return nil
 }
 
+// SaveProvider writes the given signed provider attributes to the store as is.
+// It is used to load attributes from genesis.
+func (k Keeper) SaveProvider(ctx sdk.Context, id types.ProviderID, prov types.Provider) {
+	store := ctx.KVStore(k.skey)
+	store.Set(providerKey(id), k.cdc.MustMarshal(&prov))
+}
+
 func (k Keeper) DeleteProviderAttributes(ctx sdk.Context, id types.ProviderID, keys []string) error {
 	store := ctx.KVStore(k.skey)
 	key := providerKey(id)
@@ -8,6 +8,7 @@ const (
 	errProviderNotFound uint32 = iota + 1
 	errInvalidAddress
 	errAttributeNotFound
+	errProviderExists
 )
 
 var (
@@ -19,4 +20,7 @@ var (
 
 	// ErrAttributeNotFound invalid trusted auditor address
 	ErrAttributeNotFound = sdkerrors.Register(ModuleName, errAttributeNotFound, "attribute not found")
+
+	// ErrProviderExists signed provider attributes already exist for auditor
+	ErrProviderExists = sdkerrors.Register(ModuleName, errProviderExists, "invalid provider: attributes already signed by auditor")
 )
@@ -22,6 +22,7 @@ import (
 	"github.com/ovrclk/akash/x/deployment/keeper"
 	types "github.com/ovrclk/akash/x/deployment/types/v1beta2"
 	mkeeper "github.com/ovrclk/akash/x/market/keeper"
+	mtypes "github.com/ovrclk/akash/x/market/types/v1beta2"
 )
 
 type testSuite struct {
@@ -421,6 +422,83 @@ func TestFundedDeployment(t *testing.T) {
 	require.Equal(t, sdk.NewDecCoin(msg.Deposit.Denom, sdk.ZeroInt()), acc.Funds)
 }
 
+func TestStartGroup(t *testing.T) {
+	tests := []struct {
+		name  string
+		close func(suite *testSuite, deployment types.Deployment, group types.Group)
+		err   error
+	}{
+		{
+			name: "paused",
+			close: func(suite *testSuite, _ types.Deployment, group types.Group) {
+				res, err := suite.handler(suite.ctx, &types.MsgPauseGroup{ID: group.ID()})
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			},
+		},
+		{
+			name: "deployment closed",
+			close: func(suite *testSuite, deployment types.Deployment, group types.Group) {
+				// mirrors the escrow hook closing an overdrawn deployment
+				suite.dkeeper.CloseDeployment(suite.ctx, deployment)
+				require.NoError(t, suite.dkeeper.OnCloseGroup(suite.ctx, group, types.GroupInsufficientFunds))
+				suite.mkeeper.OnGroupClosed(suite.ctx, group.ID())
+			},
+			err: types.ErrDeploymentClosed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			suite := setupTestSuite(t)
+
+			deployment, groups := suite.createDeployment()
+
+			msg := &types.MsgCreateDeployment{
+				ID:        deployment.ID(),
+				Groups:    make([]types.GroupSpec, 0, len(groups)),
+				Deposit:   types.DefaultDeploymentMinDeposit,
+				Depositor: deployment.ID().Owner,
+			}
+
+			for _, group := range groups {
+				msg.Groups = append(msg.Groups, group.GroupSpec)
+			}
+
+			res, err := suite.handler(suite.ctx, msg)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			deployment, found := suite.dkeeper.GetDeployment(suite.ctx, deployment.ID())
+			require.True(t, found)
+
+			group, found := suite.dkeeper.GetGroup(suite.ctx, types.MakeGroupID(deployment.ID(), 1))
+			require.True(t, found)
+
+			test.close(suite, deployment, group)
+
+			res, err = suite.handler(suite.ctx, &types.MsgStartGroup{ID: group.ID()})
+
+			orders := 0
+			suite.mkeeper.WithOrdersForGroup(suite.ctx, group.ID(), func(_ mtypes.Order) bool {
+				orders++
+				return false
+			})
+
+			if test.err != nil {
+				require.Nil(t, res)
+				require.True(t, errors.Is(err, test.err))
+				require.Equal(t, 1, orders)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			require.Equal(t, 2, orders)
+		})
+	}
+}
+
 func (st *testSuite) createDeployment() (types.Deployment, []types.Group) {
 	st.t.Helper()
 
@@ -280,6 +280,16 @@ func (ms msgServer) StartGroup(goCtx context.Context, msg *types.MsgStartGroup)
 		return &types.MsgStartGroupResponse{}, err
 	}
 
+	deployment, found := ms.deployment.GetDeployment(ctx, group.ID().DeploymentID())
+	if !found {
+		return &types.MsgStartGroupResponse{}, types.ErrDeploymentNotFound
+	}
+
+	// insufficient funds groups are left behind by a closed deployment
+	if deployment.State != types.DeploymentActive {
+		return &types.MsgStartGroupResponse{}, types.ErrDeploymentClosed
+	}
+
 	err = ms.deployment.OnStartGroup(ctx, group)
 	if err != nil {
 		return &types.MsgStartGroupResponse{}, err
@@ -37,7 +37,7 @@ func (k Querier) Deployments(c context.Context, req *types.QueryDeploymentsReque
 
 	searchPrefix, err := deploymentPrefixFromFilter(req.Filters)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	depStore := prefix.NewStore(ctx.KVStore(k.skey), searchPrefix)
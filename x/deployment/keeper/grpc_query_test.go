@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -401,6 +403,17 @@ func TestGRPCQueryDeploymentsWithFilter(t *testing.T) {
 	}
 }
 
+func TestGRPCQueryDeploymentsInvalidOwner(t *testing.T) {
+	suite := setupTest(t)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.queryClient.Deployments(ctx, &types.QueryDeploymentsRequest{
+		Filters: types.DeploymentFilters{Owner: "akash1invalid"},
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestGRPCQueryGroup(t *testing.T) {
 	suite := setupTest(t)
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ovrclk/akash/sdkutil"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 
 	types "github.com/ovrclk/akash/x/deployment/types/v1beta2"
@@ -50,7 +51,12 @@ func filterToPrefix(prefix []byte, owner string, dseq uint64, gseq uint32) ([]by
 		return buf.Bytes(), nil
 	}
 
-	if _, err := buf.Write(address.MustLengthPrefix(sdkutil.MustAccAddressFromBech32(owner))); err != nil {
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := buf.Write(address.MustLengthPrefix(ownerAddr)); err != nil {
 		return nil, err
 	}
 
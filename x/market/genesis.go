@@ -4,11 +4,54 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ovrclk/akash/x/market/keeper"
 	types "github.com/ovrclk/akash/x/market/types/v1beta2"
+	"github.com/pkg/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // ValidateGenesis does validation check of the Genesis
 func ValidateGenesis(data *types.GenesisState) error {
+	orders := make(map[types.OrderID]struct{}, len(data.Orders))
+	bids := make(map[types.BidID]struct{}, len(data.Bids))
+	leases := make(map[types.LeaseID]struct{}, len(data.Leases))
+
+	for idx, order := range data.Orders {
+		if err := order.ID().Validate(); err != nil {
+			return errors.Wrapf(err, "error with order %s (idx %v)", order.ID(), idx)
+		}
+		if _, found := orders[order.ID()]; found {
+			return errors.Wrapf(types.ErrOrderExists, "duplicate order %s (idx %v)", order.ID(), idx)
+		}
+		orders[order.ID()] = struct{}{}
+	}
+
+	for idx, bid := range data.Bids {
+		if err := bid.ID().Validate(); err != nil {
+			return errors.Wrapf(err, "error with bid %s (idx %v)", bid.ID(), idx)
+		}
+		if _, found := bids[bid.ID()]; found {
+			return errors.Wrapf(types.ErrBidExists, "duplicate bid %s (idx %v)", bid.ID(), idx)
+		}
+		if _, found := orders[bid.ID().OrderID()]; !found {
+			return errors.Wrapf(types.ErrOrderNotFound, "no order for bid %s (idx %v)", bid.ID(), idx)
+		}
+		bids[bid.ID()] = struct{}{}
+	}
+
+	for idx, lease := range data.Leases {
+		if err := lease.ID().Validate(); err != nil {
+			return errors.Wrapf(err, "error with lease %s (idx %v)", lease.ID(), idx)
+		}
+		if _, found := leases[lease.ID()]; found {
+			return errors.Wrapf(types.ErrLeaseExists, "duplicate lease %s (idx %v)", lease.ID(), idx)
+		}
+
+		// leases are closed and withdrawn through their bid
+		if _, found := bids[lease.ID().BidID()]; !found {
+			return errors.Wrapf(types.ErrBidNotFound, "no bid for lease %s (idx %v)", lease.ID(), idx)
+		}
+		leases[lease.ID()] = struct{}{}
+	}
+
 	return data.Params.Validate()
 }
 
@@ -22,14 +65,34 @@ func DefaultGenesisState() *types.GenesisState {
 
 // InitGenesis initiate genesis state and return updated validator details
 func InitGenesis(ctx sdk.Context, keeper keeper.IKeeper, data *types.GenesisState) []abci.ValidatorUpdate {
+	for idx := range data.Orders {
+		keeper.SaveOrder(ctx, data.Orders[idx])
+	}
+	for idx := range data.Bids {
+		keeper.SaveBid(ctx, data.Bids[idx])
+	}
+	for idx := range data.Leases {
+		keeper.SaveLease(ctx, data.Leases[idx])
+	}
 	keeper.SetParams(ctx, data.Params)
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns genesis state as raw bytes for the market module
 func ExportGenesis(ctx sdk.Context, k keeper.IKeeper) *types.GenesisState {
-	params := k.GetParams(ctx)
-	return &types.GenesisState{
-		Params: params,
-	}
+	state := &types.GenesisState{}
+	k.WithOrders(ctx, func(obj types.Order) bool {
+		state.Orders = append(state.Orders, obj)
+		return false
+	})
+	k.WithBids(ctx, func(obj types.Bid) bool {
+		state.Bids = append(state.Bids, obj)
+		return false
+	})
+	k.WithLeases(ctx, func(obj types.Lease) bool {
+		state.Leases = append(state.Leases, obj)
+		return false
+	})
+	state.Params = k.GetParams(ctx)
+	return state
 }
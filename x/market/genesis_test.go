@@ -0,0 +1,110 @@
+package market_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/testutil/state"
+	"github.com/ovrclk/akash/x/market"
+	types "github.com/ovrclk/akash/x/market/types/v1beta2"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	suite := state.SetupTestSuite(t)
+	ctx := suite.Context()
+	keeper := suite.MarketKeeper()
+
+	group := testutil.DeploymentGroup(t, testutil.DeploymentID(t), 1)
+
+	order, err := keeper.CreateOrder(ctx, group.ID(), group.GroupSpec)
+	require.NoError(t, err)
+
+	bid, err := keeper.CreateBid(ctx, order.ID(), testutil.AccAddress(t), testutil.AkashDecCoinRandom(t))
+	require.NoError(t, err)
+
+	keeper.CreateLease(ctx, bid)
+	keeper.OnBidMatched(ctx, bid)
+	keeper.OnOrderMatched(ctx, order)
+
+	exported := market.ExportGenesis(ctx, keeper)
+	require.Len(t, exported.Orders, 1)
+	require.Len(t, exported.Bids, 1)
+	require.Len(t, exported.Leases, 1)
+	require.NoError(t, market.ValidateGenesis(exported))
+
+	isuite := state.SetupTestSuite(t)
+	ictx := isuite.Context()
+	ikeeper := isuite.MarketKeeper()
+
+	market.InitGenesis(ictx, ikeeper, exported)
+	require.Equal(t, exported, market.ExportGenesis(ictx, ikeeper))
+
+	lease, found := ikeeper.LeaseForOrder(ictx, order.ID())
+	require.True(t, found)
+	require.Equal(t, types.LeaseID(bid.ID()), lease.ID())
+}
+
+func TestValidateGenesisLeaseWithoutBid(t *testing.T) {
+	lease := types.Lease{
+		LeaseID: testutil.LeaseID(t),
+		State:   types.LeaseActive,
+	}
+
+	err := market.ValidateGenesis(&types.GenesisState{
+		Leases: []types.Lease{lease},
+		Params: types.DefaultParams(),
+	})
+	require.ErrorIs(t, err, types.ErrBidNotFound)
+}
+
+func TestValidateGenesisBidWithoutOrder(t *testing.T) {
+	bid := types.Bid{
+		BidID: testutil.LeaseID(t).BidID(),
+		State: types.BidOpen,
+	}
+
+	err := market.ValidateGenesis(&types.GenesisState{
+		Bids:   []types.Bid{bid},
+		Params: types.DefaultParams(),
+	})
+	require.ErrorIs(t, err, types.ErrOrderNotFound)
+}
+
+func TestValidateGenesisDuplicateOrder(t *testing.T) {
+	genesis := validGenesis(t)
+	genesis.Orders = append(genesis.Orders, genesis.Orders[0])
+
+	require.ErrorIs(t, market.ValidateGenesis(genesis), types.ErrOrderExists)
+}
+
+func TestValidateGenesisDuplicateBid(t *testing.T) {
+	genesis := validGenesis(t)
+	genesis.Bids = append(genesis.Bids, genesis.Bids[0])
+
+	require.ErrorIs(t, market.ValidateGenesis(genesis), types.ErrBidExists)
+}
+
+func TestValidateGenesisDuplicateLease(t *testing.T) {
+	genesis := validGenesis(t)
+	genesis.Leases = append(genesis.Leases, genesis.Leases[0])
+
+	require.ErrorIs(t, market.ValidateGenesis(genesis), types.ErrLeaseExists)
+}
+
+func validGenesis(t testing.TB) *types.GenesisState {
+	t.Helper()
+
+	lid := testutil.LeaseID(t)
+
+	genesis := &types.GenesisState{
+		Orders: []types.Order{{OrderID: lid.OrderID(), State: types.OrderActive}},
+		Bids:   []types.Bid{{BidID: lid.BidID(), State: types.BidActive}},
+		Leases: []types.Lease{{LeaseID: lid, State: types.LeaseActive}},
+		Params: types.DefaultParams(),
+	}
+	require.NoError(t, market.ValidateGenesis(genesis))
+
+	return genesis
+}
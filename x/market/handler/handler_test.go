@@ -174,6 +174,53 @@ func TestCreateBidOverprice(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestCreateBidFractionalPrice(t *testing.T) {
+	// 0.7 + 0.1 sums to 0.7999999999999999 in float64
+	resources := []dtypes.Resource{
+		{
+			Count: 1,
+			Price: sdk.NewDecCoinFromDec(testutil.CoinDenom, sdk.MustNewDecFromStr("0.7")),
+		},
+		{
+			Count: 1,
+			Price: sdk.NewDecCoinFromDec(testutil.CoinDenom, sdk.MustNewDecFromStr("0.1")),
+		},
+	}
+
+	tests := []struct {
+		price string
+		valid bool
+	}{
+		{price: "0.8", valid: true},
+		{price: "0.799999999999999999", valid: true},
+		{price: "0.800000000000000001", valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.price, func(t *testing.T) {
+			suite := setupTestSuite(t)
+			order, gspec := suite.createOrder(resources)
+
+			msg := &types.MsgCreateBid{
+				Order:    order.ID(),
+				Provider: suite.createProvider(gspec.Requirements.Attributes).Owner,
+				Price:    sdk.NewDecCoinFromDec(testutil.CoinDenom, sdk.MustNewDecFromStr(test.price)),
+				Deposit:  types.DefaultBidMinDeposit,
+			}
+
+			res, err := suite.handler(suite.Context(), msg)
+			if test.valid {
+				require.NotNil(t, res)
+				require.NoError(t, err)
+				return
+			}
+
+			require.Nil(t, res)
+			require.True(t, errors.Is(err, types.ErrBidOverOrder))
+		})
+	}
+}
+
 func TestCreateBidInvalidProvider(t *testing.T) {
 	suite := setupTestSuite(t)
 
@@ -403,6 +450,49 @@ func TestCloseBidUnknownOrder(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestCreateLeasePartialFailure(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	bid, order := suite.createBid()
+
+	owner, err := sdk.AccAddressFromBech32(order.ID().Owner)
+	require.NoError(t, err)
+
+	did := order.ID().GroupID().DeploymentID()
+	err = suite.EscrowKeeper().AccountCreate(suite.Context(),
+		dtypes.EscrowAccountForDeployment(did),
+		owner,
+		owner,
+		sdk.NewCoin(testutil.CoinDenom, sdk.NewInt(1000000)))
+	require.NoError(t, err)
+
+	// losing bid without an escrow account fails to close after the lease has
+	// been saved. No message handler can produce this state, since MsgCreateBid
+	// always opens the bid's escrow account; the bid is created through the
+	// keeper directly to force the failure.
+	_, err = suite.MarketKeeper().CreateBid(suite.Context(), order.ID(), testutil.AccAddress(t), bid.Price)
+	require.NoError(t, err)
+
+	// deliver against a cache-wrapped context, as baseapp does for each tx
+	cctx, _ := suite.Context().CacheContext()
+
+	res, err := suite.handler(cctx, &types.MsgCreateLease{BidID: bid.ID()})
+	require.Nil(t, res)
+	require.Error(t, err)
+
+	// a lease is never written without its escrow payment
+	if _, found := suite.MarketKeeper().GetLease(cctx, bid.ID().LeaseID()); found {
+		_, err = suite.EscrowKeeper().GetPayment(cctx,
+			dtypes.EscrowAccountForDeployment(did),
+			types.EscrowPaymentForLease(bid.ID().LeaseID()))
+		require.NoError(t, err)
+	}
+
+	// nothing is persisted when the cache is discarded
+	_, found := suite.MarketKeeper().GetLease(suite.Context(), bid.ID().LeaseID())
+	require.False(t, found)
+}
+
 func (st *testSuite) createLease() (types.LeaseID, types.Bid, types.Order) {
 	st.t.Helper()
 	bid, order := st.createBid()
@@ -39,7 +39,7 @@ func (k Querier) Orders(c context.Context, req *types.QueryOrdersRequest) (*type
 	store := ctx.KVStore(k.skey)
 	searchPrefix, err := keys.OrderPrefixFromFilter(req.Filters)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	orderStore := prefix.NewStore(store, searchPrefix)
@@ -111,7 +111,7 @@ func (k Querier) Bids(c context.Context, req *types.QueryBidsRequest) (*types.Qu
 	store := ctx.KVStore(k.skey)
 	searchPrefix, err := keys.BidPrefixFromFilter(req.Filters)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	bidStore := prefix.NewStore(store, searchPrefix)
@@ -203,7 +203,7 @@ func (k Querier) Leases(c context.Context, req *types.QueryLeasesRequest) (*type
 	store := ctx.KVStore(k.skey)
 	searchPrefix, isSecondaryPrefix, err := keys.LeasePrefixFromFilter(req.Filters)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	searchedStore := prefix.NewStore(store, searchPrefix)
 
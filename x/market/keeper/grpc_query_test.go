@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -183,6 +185,64 @@ func TestGRPCQueryOrders(t *testing.T) {
 	}
 }
 
+func TestGRPCQueryInvalidFilterAddress(t *testing.T) {
+	suite := setupTest(t)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	const invalid = "akash1invalid"
+
+	testCases := []struct {
+		msg   string
+		query func() error
+	}{
+		{
+			"orders with invalid owner",
+			func() error {
+				_, err := suite.queryClient.Orders(ctx, &types.QueryOrdersRequest{
+					Filters: types.OrderFilters{Owner: invalid},
+				})
+				return err
+			},
+		},
+		{
+			"bids with invalid owner",
+			func() error {
+				_, err := suite.queryClient.Bids(ctx, &types.QueryBidsRequest{
+					Filters: types.BidFilters{Owner: invalid},
+				})
+				return err
+			},
+		},
+		{
+			"leases with invalid owner",
+			func() error {
+				_, err := suite.queryClient.Leases(ctx, &types.QueryLeasesRequest{
+					Filters: types.LeaseFilters{Owner: invalid},
+				})
+				return err
+			},
+		},
+		{
+			"leases with invalid provider",
+			func() error {
+				_, err := suite.queryClient.Leases(ctx, &types.QueryLeasesRequest{
+					Filters: types.LeaseFilters{Provider: invalid},
+				})
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("Case %s", tc.msg), func(t *testing.T) {
+			err := tc.query()
+			require.Error(t, err)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
 type orderFilterModifier struct {
 	fieldName string
 	f         func(orderID types.OrderID, filter types.OrderFilters) types.OrderFilters
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	dtypes "github.com/ovrclk/akash/x/deployment/types/v1beta2"
@@ -17,6 +18,9 @@ type IKeeper interface {
 	CreateOrder(ctx sdk.Context, gid dtypes.GroupID, spec dtypes.GroupSpec) (types.Order, error)
 	CreateBid(ctx sdk.Context, oid types.OrderID, provider sdk.AccAddress, price sdk.DecCoin) (types.Bid, error)
 	CreateLease(ctx sdk.Context, bid types.Bid)
+	SaveOrder(ctx sdk.Context, order types.Order)
+	SaveBid(ctx sdk.Context, bid types.Bid)
+	SaveLease(ctx sdk.Context, lease types.Lease)
 	OnOrderMatched(ctx sdk.Context, order types.Order)
 	OnBidMatched(ctx sdk.Context, bid types.Bid)
 	OnBidLost(ctx sdk.Context, bid types.Bid)
@@ -109,6 +113,9 @@ func (k Keeper) CreateOrder(ctx sdk.Context, gid dtypes.GroupID, spec dtypes.Gro
 		types.NewEventOrderCreated(order.ID()).
 			ToSDKEvent(),
 	)
+
+	telemetry.IncrCounter(1.0, "akash.order_created")
+
 	return order, nil
 }
 
@@ -142,8 +149,6 @@ func (k Keeper) CreateBid(ctx sdk.Context, oid types.OrderID, provider sdk.AccAd
 // CreateLease creates lease for bid with given bidID.
 // Should only be called by the EndBlock handler or unit tests.
 func (k Keeper) CreateLease(ctx sdk.Context, bid types.Bid) {
-	store := ctx.KVStore(k.skey)
-
 	lease := types.Lease{
 		LeaseID:   types.LeaseID(bid.ID()),
 		State:     types.LeaseActive,
@@ -152,8 +157,7 @@ func (k Keeper) CreateLease(ctx sdk.Context, bid types.Bid) {
 	}
 
 	// create (active) lease in store
-	key := keys.LeaseKey(lease.ID())
-	store.Set(key, k.cdc.MustMarshal(&lease))
+	k.SaveLease(ctx, lease)
 
 	ctx.Logger().Info("created lease", "lease", lease.ID())
 	ctx.EventManager().EmitEvent(
@@ -161,6 +165,28 @@ func (k Keeper) CreateLease(ctx sdk.Context, bid types.Bid) {
 			ToSDKEvent(),
 	)
 
+	telemetry.IncrCounter(1.0, "akash.leases")
+}
+
+// SaveOrder writes the given order to the store as is. It is used to
+// load orders from genesis.
+func (k Keeper) SaveOrder(ctx sdk.Context, order types.Order) {
+	k.updateOrder(ctx, order)
+}
+
+// SaveBid writes the given bid to the store as is. It is used to
+// load bids from genesis.
+func (k Keeper) SaveBid(ctx sdk.Context, bid types.Bid) {
+	k.updateBid(ctx, bid)
+}
+
+// SaveLease writes the given lease and its secondary keys to the store
+func (k Keeper) SaveLease(ctx sdk.Context, lease types.Lease) {
+	store := ctx.KVStore(k.skey)
+
+	key := keys.LeaseKey(lease.ID())
+	store.Set(key, k.cdc.MustMarshal(&lease))
+
 	secondaryKeys := keys.SecondaryKeysForLease(lease.ID())
 	for _, secondaryKey := range secondaryKeys {
 		store.Set(secondaryKey, key)
@@ -214,6 +240,8 @@ func (k Keeper) OnOrderClosed(ctx sdk.Context, order types.Order) {
 		types.NewEventOrderClosed(order.ID()).
 			ToSDKEvent(),
 	)
+
+	telemetry.IncrCounter(1.0, "akash.order_closed_total")
 }
 
 // OnLeaseClosed updates lease state to closed
@@ -230,6 +258,8 @@ func (k Keeper) OnLeaseClosed(ctx sdk.Context, lease types.Lease, state types.Le
 		types.NewEventLeaseClosed(lease.ID(), lease.Price).
 			ToSDKEvent(),
 	)
+
+	telemetry.IncrCounter(1.0, "akash.lease_closed")
 }
 
 // OnGroupClosed updates state of all orders, bids and leases in group to closed
@@ -320,7 +350,8 @@ func (k Keeper) LeaseForOrder(ctx sdk.Context, oid types.OrderID) (types.Lease,
 	return value, found
 }
 
-// WithOrders iterates all orders in market
+// WithOrders iterates all orders in market, ordered by the length-prefixed owner
+// address bytes (not the bech32 string), then by dseq, gseq and oseq
 func (k Keeper) WithOrders(ctx sdk.Context, fn func(types.Order) bool) {
 	store := ctx.KVStore(k.skey)
 	iter := sdk.KVStorePrefixIterator(store, types.OrderPrefix())
@@ -362,7 +393,8 @@ func (k Keeper) WithLeases(ctx sdk.Context, fn func(types.Lease) bool) {
 	}
 }
 
-// WithOrdersForGroup iterates all orders of a group in market with given GroupID
+// WithOrdersForGroup iterates all orders of a group in market with given GroupID,
+// in ascending oseq order
 func (k Keeper) WithOrdersForGroup(ctx sdk.Context, id dtypes.GroupID, fn func(types.Order) bool) {
 	store := ctx.KVStore(k.skey)
 	iter := sdk.KVStorePrefixIterator(store, keys.OrdersForGroupPrefix(id))
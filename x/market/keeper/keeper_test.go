@@ -12,6 +12,7 @@ import (
 	"github.com/ovrclk/akash/testutil/state"
 	dtypes "github.com/ovrclk/akash/x/deployment/types/v1beta2"
 	"github.com/ovrclk/akash/x/market/keeper"
+	"github.com/ovrclk/akash/x/market/keeper/keys"
 	types "github.com/ovrclk/akash/x/market/types/v1beta2"
 )
 
@@ -24,6 +25,42 @@ func Test_CreateOrder(t *testing.T) {
 	require.Error(t, err)
 }
 
+func Test_CreateOrderSequence(t *testing.T) {
+	ctx, keeper, _ := setupKeeper(t)
+	order, gspec := createOrder(t, ctx, keeper)
+
+	// each close and recreate takes the next oseq for the group
+	for oseq := uint32(2); oseq <= 4; oseq++ {
+		keeper.OnOrderClosed(ctx, order)
+
+		var err error
+		order, err = keeper.CreateOrder(ctx, order.ID().GroupID(), gspec)
+		require.NoError(t, err)
+		require.Equal(t, oseq, order.ID().OSeq)
+	}
+
+	var oseqs []uint32
+	keeper.WithOrdersForGroup(ctx, order.ID().GroupID(), func(result types.Order) bool {
+		oseqs = append(oseqs, result.ID().OSeq)
+		return false
+	})
+	require.Equal(t, []uint32{1, 2, 3, 4}, oseqs)
+}
+
+func Test_CreateOrderCreatedAt(t *testing.T) {
+	_, keeper, suite := setupKeeper(t)
+
+	const testBlockHeight = 42
+	suite.SetBlockHeight(testBlockHeight)
+
+	order, _ := createOrder(t, suite.Context(), keeper)
+	assert.Equal(t, int64(testBlockHeight), order.CreatedAt)
+
+	result, ok := keeper.GetOrder(suite.Context(), order.ID())
+	require.True(t, ok)
+	assert.Equal(t, int64(testBlockHeight), result.CreatedAt)
+}
+
 func Test_GetOrder(t *testing.T) {
 	ctx, keeper, _ := setupKeeper(t)
 	order, _ := createOrder(t, ctx, keeper)
@@ -38,6 +75,32 @@ func Test_GetOrder(t *testing.T) {
 	}
 }
 
+func Test_OrderKey(t *testing.T) {
+	ctx, keeper, suite := setupKeeper(t)
+	group := testutil.DeploymentGroup(t, testutil.DeploymentID(t), 1)
+
+	order, err := keeper.CreateOrder(ctx, group.ID(), group.GroupSpec)
+	require.NoError(t, err)
+
+	key := keys.OrderKey(order.ID())
+
+	buf := ctx.KVStore(suite.App().GetKey(types.StoreKey)).Get(key)
+	require.NotNil(t, buf)
+
+	var result types.Order
+	keeper.Codec().MustUnmarshal(buf, &result)
+	require.Equal(t, order, result)
+
+	prefix, err := keys.OrderPrefixFromFilter(types.OrderFilters{
+		Owner: order.ID().Owner,
+		DSeq:  order.ID().DSeq,
+		GSeq:  order.ID().GSeq,
+		OSeq:  order.ID().OSeq,
+	})
+	require.NoError(t, err)
+	require.Equal(t, key, prefix)
+}
+
 func Test_WithOrders(t *testing.T) {
 	ctx, keeper, _ := setupKeeper(t)
 	order, _ := createOrder(t, ctx, keeper)
@@ -71,6 +134,53 @@ func Test_WithOrdersForGroup(t *testing.T) {
 	assert.Equal(t, 1, count)
 }
 
+func Test_WithOrdersForGroupOrdering(t *testing.T) {
+	ctx, keeper, _ := setupKeeper(t)
+	group := testutil.DeploymentGroup(t, testutil.DeploymentID(t), 1)
+
+	// store out of order, and past oseq 9 so the big-endian key encoding is
+	// what sorts them rather than insertion order
+	for _, oseq := range []uint32{12, 3, 1, 10, 2} {
+		keeper.SaveOrder(ctx, types.Order{
+			OrderID: types.MakeOrderID(group.ID(), oseq),
+			Spec:    group.GroupSpec,
+			State:   types.OrderClosed,
+		})
+	}
+
+	collect := func() []uint32 {
+		var oseqs []uint32
+		keeper.WithOrdersForGroup(ctx, group.ID(), func(result types.Order) bool {
+			oseqs = append(oseqs, result.ID().OSeq)
+			return false
+		})
+		return oseqs
+	}
+
+	expected := []uint32{1, 2, 3, 10, 12}
+	require.Equal(t, expected, collect())
+	require.Equal(t, expected, collect())
+}
+
+func Test_WithOrdersOrdering(t *testing.T) {
+	ctx, keeper, _ := setupKeeper(t)
+	did := testutil.DeploymentID(t)
+
+	for _, gseq := range []uint32{10, 3, 1, 2} {
+		group := testutil.DeploymentGroup(t, did, gseq)
+		_, err := keeper.CreateOrder(ctx, group.ID(), group.GroupSpec)
+		require.NoError(t, err)
+	}
+
+	var gseqs []uint32
+	keeper.WithOrders(ctx, func(result types.Order) bool {
+		gseqs = append(gseqs, result.ID().GSeq)
+		return false
+	})
+
+	require.Equal(t, []uint32{1, 2, 3, 10}, gseqs)
+}
+
 func Test_CreateBid(t *testing.T) {
 	_, _, suite := setupKeeper(t)
 	createBid(t, suite)
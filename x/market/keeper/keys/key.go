@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/ovrclk/akash/sdkutil"
 	dtypes "github.com/ovrclk/akash/x/deployment/types/v1beta2"
@@ -17,7 +18,12 @@ func filterToPrefix(prefix []byte, owner string, dseq uint64, gseq, oseq uint32,
 		return buf.Bytes(), nil
 	}
 
-	if _, err := buf.Write(address.MustLengthPrefix(sdkutil.MustAccAddressFromBech32(owner))); err != nil {
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := buf.Write(address.MustLengthPrefix(ownerAddr)); err != nil {
 		return nil, err
 	}
 
@@ -46,7 +52,12 @@ func filterToPrefix(prefix []byte, owner string, dseq uint64, gseq, oseq uint32,
 		return buf.Bytes(), nil
 	}
 
-	if _, err := buf.Write(address.MustLengthPrefix(sdkutil.MustAccAddressFromBech32(provider))); err != nil {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := buf.Write(address.MustLengthPrefix(providerAddr)); err != nil {
 		return nil, err
 	}
 
@@ -64,7 +75,12 @@ func filterToSecondaryPrefixByProvider(prefix []byte, owner string, dseq uint64,
 		return buf.Bytes(), nil
 	}
 
-	if _, err := buf.Write(address.MustLengthPrefix(sdkutil.MustAccAddressFromBech32(provider))); err != nil {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := buf.Write(address.MustLengthPrefix(providerAddr)); err != nil {
 		return nil, err
 	}
 
@@ -72,7 +88,12 @@ func filterToSecondaryPrefixByProvider(prefix []byte, owner string, dseq uint64,
 		return buf.Bytes(), nil
 	}
 
-	if _, err := buf.Write(address.MustLengthPrefix(sdkutil.MustAccAddressFromBech32(owner))); err != nil {
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := buf.Write(address.MustLengthPrefix(ownerAddr)); err != nil {
 		return nil, err
 	}
 
@@ -31,3 +31,20 @@ func TestKeysAndSecondaryKeysFilter(t *testing.T) {
 	require.True(t, isSecondary)
 	require.Equal(t, types.SecondaryLeasePrefix(), prefix[0:2])
 }
+
+func TestFilterInvalidAddress(t *testing.T) {
+	_, err := keys.OrderPrefixFromFilter(types.OrderFilters{Owner: "akash1invalid"})
+	require.Error(t, err)
+
+	_, err = keys.BidPrefixFromFilter(types.BidFilters{
+		Owner:    "akash104fq56d9attl4m709h7mgx9lwqklnh05fhy5nu",
+		DSeq:     1,
+		GSeq:     2,
+		OSeq:     3,
+		Provider: "akash1invalid",
+	})
+	require.Error(t, err)
+
+	_, _, err = keys.LeasePrefixFromFilter(types.LeaseFilters{Provider: "akash1invalid"})
+	require.Error(t, err)
+}
@@ -37,6 +37,7 @@ const (
 	errUnknownProvider
 	errInvalidBid
 	errCodeCapabilitiesMismatch
+	errCodeLeaseExists
 )
 
 var (
@@ -104,4 +105,6 @@ var (
 	ErrUnknownProvider = sdkerrors.Register(ModuleName, errUnknownProvider, "unknown provider")
 	// ErrInvalidBid indicates an invalid chain parameter
 	ErrInvalidBid = sdkerrors.Register(ModuleName, errInvalidBid, "unknown provider")
+	// ErrLeaseExists lease exists
+	ErrLeaseExists = sdkerrors.Register(ModuleName, errCodeLeaseExists, "invalid lease: lease exists")
 )
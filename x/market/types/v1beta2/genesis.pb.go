@@ -28,6 +28,7 @@ type GenesisState struct {
 	Orders []Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders" yaml:"orders"`
 	Leases []Lease `protobuf:"bytes,2,rep,name=leases,proto3" json:"leases" yaml:"leases"`
 	Params Params  `protobuf:"bytes,3,opt,name=params,proto3" json:"params" yaml:"params"`
+	Bids   []Bid   `protobuf:"bytes,4,rep,name=bids,proto3" json:"bids" yaml:"bids"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +85,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetBids() []Bid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "akash.market.v1beta2.GenesisState")
 }
@@ -93,26 +101,28 @@ func init() {
 }
 
 var fileDescriptor_e3591e07a3cf8f44 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x14, 0x45, 0xe3, 0x56, 0xca, 0x90, 0xc2, 0x12, 0x75, 0x88, 0x0a, 0x72, 0x82, 0xa7, 0x4e, 0xb6,
-	0x08, 0x1b, 0x63, 0x17, 0x18, 0x90, 0x40, 0x01, 0x16, 0x36, 0xa7, 0x58, 0x69, 0x95, 0x04, 0x47,
-	0xb6, 0xa9, 0xe8, 0x5f, 0xc0, 0x5f, 0x75, 0xec, 0xc8, 0x14, 0xa1, 0x64, 0x63, 0xe4, 0x0b, 0x50,
-	0x6c, 0x57, 0x59, 0xa2, 0x6e, 0x79, 0x7a, 0xe7, 0x9d, 0x5c, 0x5f, 0x0f, 0xd1, 0x9c, 0xca, 0x15,
-	0x29, 0xa9, 0xc8, 0x99, 0x22, 0x9b, 0xcb, 0x94, 0x29, 0x1a, 0x93, 0x8c, 0xbd, 0x31, 0xb9, 0x96,
-	0xb8, 0x12, 0x5c, 0x71, 0x7f, 0xaa, 0x19, 0x6c, 0x18, 0x6c, 0x99, 0xd9, 0x34, 0xe3, 0x19, 0xd7,
-	0x00, 0xe9, 0xbe, 0x0c, 0x3b, 0x8b, 0x06, 0x7d, 0x5c, 0xbc, 0x32, 0x71, 0x94, 0x28, 0x18, 0x95,
-	0xcc, 0x12, 0x17, 0x83, 0x44, 0x45, 0x05, 0x2d, 0x6d, 0x24, 0xf4, 0x35, 0xf2, 0x4e, 0x6e, 0x4c,
-	0xc8, 0x47, 0x45, 0x15, 0xf3, 0x9f, 0x3c, 0x57, 0xff, 0x44, 0x06, 0x20, 0x1a, 0xcf, 0x27, 0xf1,
-	0x19, 0x1e, 0x0a, 0x8d, 0xef, 0x3b, 0x66, 0x11, 0xee, 0xea, 0xd0, 0xf9, 0xad, 0x43, 0x7b, 0xf2,
-	0x57, 0x87, 0xa7, 0x5b, 0x5a, 0x16, 0xd7, 0xc8, 0xcc, 0x28, 0xb1, 0x8b, 0xce, 0xaa, 0x83, 0xc9,
-	0x60, 0x74, 0xcc, 0x7a, 0xd7, 0x31, 0xbd, 0xd5, 0x9c, 0xf4, 0x56, 0x33, 0xa3, 0xc4, 0x2e, 0xfc,
-	0x67, 0xcf, 0x35, 0x8f, 0x09, 0xc6, 0x11, 0x98, 0x4f, 0xe2, 0xf3, 0x61, 0xeb, 0x83, 0x66, 0x7a,
-	0xad, 0xb9, 0xe9, 0xb5, 0x66, 0x46, 0x89, 0x5d, 0x2c, 0x6e, 0x77, 0x0d, 0x04, 0xfb, 0x06, 0x82,
-	0x9f, 0x06, 0x82, 0xcf, 0x16, 0x3a, 0xfb, 0x16, 0x3a, 0xdf, 0x2d, 0x74, 0x5e, 0x70, 0xb6, 0x56,
-	0xab, 0xf7, 0x14, 0x2f, 0x79, 0x49, 0xf8, 0x46, 0x2c, 0x8b, 0x9c, 0x98, 0x8a, 0x3f, 0x0e, 0x25,
-	0xab, 0x6d, 0xc5, 0xe4, 0xa1, 0xea, 0xd4, 0xd5, 0x25, 0x5f, 0xfd, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0xfe, 0xbe, 0xd9, 0xa2, 0x1d, 0x02, 0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x10, 0xc7, 0x93, 0xb6, 0xea, 0x90, 0x7e, 0xdf, 0x12, 0x75, 0x08, 0x2d, 0x72, 0x8a, 0xa7, 0x4e,
+	0xb6, 0x28, 0x1b, 0x63, 0x16, 0x10, 0x42, 0x02, 0x05, 0x58, 0xd8, 0x9c, 0xc6, 0x4a, 0xa3, 0x36,
+	0xb8, 0xb2, 0x4d, 0x45, 0xdf, 0x82, 0xc7, 0xea, 0xd8, 0x91, 0x85, 0x08, 0x25, 0x1b, 0x63, 0x9f,
+	0x00, 0xc5, 0x76, 0x95, 0xc5, 0xea, 0xe6, 0xf3, 0xff, 0x77, 0x3f, 0xdd, 0xe9, 0x3c, 0x48, 0x96,
+	0x44, 0x2c, 0x70, 0x41, 0xf8, 0x92, 0x4a, 0xbc, 0xb9, 0x4c, 0xa8, 0x24, 0x33, 0x9c, 0xd1, 0x37,
+	0x2a, 0x72, 0x81, 0xd6, 0x9c, 0x49, 0xe6, 0x0f, 0x15, 0x83, 0x34, 0x83, 0x0c, 0x33, 0x1a, 0x66,
+	0x2c, 0x63, 0x0a, 0xc0, 0xcd, 0x4b, 0xb3, 0xa3, 0x89, 0xd5, 0xc7, 0x78, 0x4a, 0xf9, 0x49, 0x62,
+	0x45, 0x89, 0xa0, 0x86, 0x00, 0x56, 0x22, 0xc9, 0x53, 0x93, 0x5f, 0x58, 0xf3, 0x35, 0xe1, 0xa4,
+	0x30, 0x23, 0xc3, 0xef, 0x8e, 0xf7, 0xef, 0x46, 0x2f, 0xf1, 0x24, 0x89, 0xa4, 0xfe, 0xb3, 0xd7,
+	0x57, 0x43, 0x88, 0xc0, 0x9d, 0x74, 0xa7, 0x83, 0xd9, 0x18, 0xd9, 0x96, 0x42, 0x0f, 0x0d, 0x13,
+	0x85, 0xbb, 0x32, 0x74, 0x7e, 0xcb, 0xd0, 0xb4, 0x1c, 0xca, 0xf0, 0xff, 0x96, 0x14, 0xab, 0x6b,
+	0xa8, 0x6b, 0x18, 0x9b, 0xa0, 0xb1, 0xaa, 0xc1, 0x45, 0xd0, 0x39, 0x65, 0xbd, 0x6f, 0x98, 0xd6,
+	0xaa, 0x5b, 0x5a, 0xab, 0xae, 0x61, 0x6c, 0x02, 0xff, 0xc5, 0xeb, 0xeb, 0x65, 0x82, 0xee, 0xc4,
+	0x9d, 0x0e, 0x66, 0xe7, 0x76, 0xeb, 0xa3, 0x62, 0x5a, 0xad, 0xee, 0x69, 0xb5, 0xba, 0x86, 0xb1,
+	0x09, 0xfc, 0x3b, 0xaf, 0x97, 0xe4, 0xa9, 0x08, 0x7a, 0x6a, 0xd4, 0x33, 0xbb, 0x34, 0xca, 0xd3,
+	0x68, 0x6c, 0x8c, 0x0a, 0x3f, 0x94, 0xe1, 0x40, 0xfb, 0x9a, 0x0a, 0xc6, 0xea, 0x33, 0xba, 0xdd,
+	0x55, 0xc0, 0xdd, 0x57, 0xc0, 0xfd, 0xa9, 0x80, 0xfb, 0x59, 0x03, 0x67, 0x5f, 0x03, 0xe7, 0xab,
+	0x06, 0xce, 0x2b, 0xca, 0x72, 0xb9, 0x78, 0x4f, 0xd0, 0x9c, 0x15, 0x98, 0x6d, 0xf8, 0x7c, 0xb5,
+	0xc4, 0xfa, 0x5c, 0x1f, 0xc7, 0x83, 0xc9, 0xed, 0x9a, 0x8a, 0xe3, 0xd9, 0x92, 0xbe, 0x3a, 0xd8,
+	0xd5, 0xdf, 0x00, 0x23, 0xfe, 0x80, 0xe8, 0x89, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +145,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -207,6 +231,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -346,6 +376,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,22 +4,44 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ovrclk/akash/x/provider/keeper"
 	types "github.com/ovrclk/akash/x/provider/types/v1beta2"
+	"github.com/pkg/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // ValidateGenesis does validation check of the Genesis and returns error incase of failure
 func ValidateGenesis(data *types.GenesisState) error {
+	providers := make(map[string]struct{}, len(data.Providers))
+
+	for idx, provider := range data.Providers {
+		owner, err := sdk.AccAddressFromBech32(provider.Owner)
+		if err != nil {
+			return errors.Wrapf(types.ErrInvalidAddress, "error with provider %s (idx %v): %s", provider.Owner, idx, err)
+		}
+		if _, found := providers[owner.String()]; found {
+			return errors.Wrapf(types.ErrProviderExists, "duplicate provider %s (idx %v)", provider.Owner, idx)
+		}
+		providers[owner.String()] = struct{}{}
+	}
+
 	return nil
 }
 
 // InitGenesis initiate genesis state and return updated validator details
 func InitGenesis(ctx sdk.Context, keeper keeper.IKeeper, data *types.GenesisState) []abci.ValidatorUpdate {
+	for idx := range data.Providers {
+		keeper.Save(ctx, data.Providers[idx])
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns genesis state as raw bytes for the provider module
 func ExportGenesis(ctx sdk.Context, k keeper.IKeeper) *types.GenesisState {
-	return &types.GenesisState{}
+	state := &types.GenesisState{}
+	k.WithProviders(ctx, func(obj types.Provider) bool {
+		state.Providers = append(state.Providers, obj)
+		return false
+	})
+	return state
 }
 
 // DefaultGenesisState returns default genesis state as raw bytes for the provider
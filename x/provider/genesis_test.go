@@ -0,0 +1,51 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/testutil/state"
+	"github.com/ovrclk/akash/x/provider"
+	types "github.com/ovrclk/akash/x/provider/types/v1beta2"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	suite := state.SetupTestSuite(t)
+	ctx := suite.Context()
+	keeper := suite.ProviderKeeper()
+
+	prov := testutil.Provider(t)
+	require.NoError(t, keeper.Create(ctx, prov))
+
+	exported := provider.ExportGenesis(ctx, keeper)
+	require.Equal(t, []types.Provider{prov}, exported.Providers)
+	require.NoError(t, provider.ValidateGenesis(exported))
+
+	isuite := state.SetupTestSuite(t)
+	ictx := isuite.Context()
+	ikeeper := isuite.ProviderKeeper()
+
+	provider.InitGenesis(ictx, ikeeper, exported)
+	require.Equal(t, exported, provider.ExportGenesis(ictx, ikeeper))
+
+	result, found := ikeeper.Get(ictx, prov.Address())
+	require.True(t, found)
+	require.Equal(t, prov, result)
+}
+
+func TestValidateGenesisInvalidOwner(t *testing.T) {
+	prov := testutil.Provider(t)
+	prov.Owner = "akash1invalid"
+
+	err := provider.ValidateGenesis(&types.GenesisState{Providers: []types.Provider{prov}})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+}
+
+func TestValidateGenesisDuplicateProvider(t *testing.T) {
+	prov := testutil.Provider(t)
+
+	err := provider.ValidateGenesis(&types.GenesisState{Providers: []types.Provider{prov, prov}})
+	require.ErrorIs(t, err, types.ErrProviderExists)
+}
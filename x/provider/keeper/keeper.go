@@ -13,6 +13,7 @@ type IKeeper interface {
 	Create(ctx sdk.Context, provider types.Provider) error
 	WithProviders(ctx sdk.Context, fn func(types.Provider) bool)
 	Update(ctx sdk.Context, provider types.Provider) error
+	Save(ctx sdk.Context, provider types.Provider)
 	Delete(ctx sdk.Context, id sdk.Address)
 	NewQuerier() Querier
 }
@@ -114,6 +115,13 @@ func (k Keeper) Update(ctx sdk.Context, provider types.Provider) error {
 	return nil
 }
 
+// Save writes the given provider to the store as is. It is used to
+// load providers from genesis.
+func (k Keeper) Save(ctx sdk.Context, provider types.Provider) {
+	store := ctx.KVStore(k.skey)
+	store.Set(providerKey(provider.Address()), k.cdc.MustMarshal(&provider))
+}
+
 // Delete delete a provider
 func (k Keeper) Delete(ctx sdk.Context, id sdk.Address) {
 	panic("TODO")